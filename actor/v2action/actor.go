@@ -0,0 +1,87 @@
+package v2action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// Warnings is a list of warnings returned back from the cloud controller
+type Warnings []string
+
+//go:generate counterfeiter . Config
+
+// Config is a read-only interface for the CC configuration needed by the
+// actor layer.
+type Config interface {
+	OverallPollingTimeout() time.Duration
+}
+
+//go:generate counterfeiter . CloudControllerClient
+
+// CloudControllerClient is the interface to the cloud controller client that
+// the actor uses. It is a subset of the full client, limited to the methods
+// this package depends on.
+type CloudControllerClient interface {
+	DeleteApplication(guid string) (ccv2.Warnings, error)
+	DeleteOrganization(guid string) (ccv2.Job, ccv2.Warnings, error)
+	DeleteRoute(guid string) (ccv2.Warnings, error)
+	DeleteServiceBinding(guid string) (ccv2.Warnings, error)
+	DeleteServiceInstance(guid string) (ccv2.Warnings, error)
+	DeleteSpace(guid string) (ccv2.Job, ccv2.Warnings, error)
+	GetApplications(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
+	GetJob(guid string) (ccv2.Job, ccv2.Warnings, error)
+	GetOrganizations(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
+	GetRoutes(queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
+	GetServiceBindings(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
+	GetServiceInstances(queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
+	GetSpaces(queries []ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error)
+}
+
+// Actor handles all business logic for the v2 actor.
+type Actor struct {
+	CloudControllerClient CloudControllerClient
+	Config                Config
+	UAAClient             uaa.Client
+}
+
+// NewActor returns a new actor.
+func NewActor(client CloudControllerClient, uaaClient uaa.Client, config Config) Actor {
+	return Actor{
+		CloudControllerClient: client,
+		Config:                config,
+		UAAClient:             uaaClient,
+	}
+}
+
+// pollJob polls the given job until it reaches a terminal state or the
+// overall polling timeout configured on the actor elapses, aggregating
+// warnings along the way.
+func (actor Actor) pollJob(job ccv2.Job) (Warnings, error) {
+	var allWarnings Warnings
+
+	timeout := time.Now().Add(actor.Config.OverallPollingTimeout())
+
+	for {
+		updatedJob, warnings, err := actor.CloudControllerClient.GetJob(job.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		if updatedJob.Status == ccv2.JobStatusFinished {
+			return allWarnings, nil
+		}
+
+		if updatedJob.Status == ccv2.JobStatusFailed {
+			return allWarnings, JobFailedError{JobGUID: job.GUID}
+		}
+
+		if time.Now().After(timeout) {
+			return allWarnings, JobTimeoutError{JobGUID: job.GUID, Timeout: actor.Config.OverallPollingTimeout()}
+		}
+
+		job = updatedJob
+	}
+}
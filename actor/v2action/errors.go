@@ -0,0 +1,26 @@
+package v2action
+
+import (
+	"fmt"
+	"time"
+)
+
+// JobFailedError is returned when a polled job reports a failed state.
+type JobFailedError struct {
+	JobGUID string
+}
+
+func (e JobFailedError) Error() string {
+	return fmt.Sprintf("Job (%s) failed", e.JobGUID)
+}
+
+// JobTimeoutError is returned when polling a job exceeds the configured
+// overall polling timeout.
+type JobTimeoutError struct {
+	JobGUID string
+	Timeout time.Duration
+}
+
+func (e JobTimeoutError) Error() string {
+	return fmt.Sprintf("Job (%s) did not finish in %s", e.JobGUID, e.Timeout)
+}
@@ -0,0 +1,279 @@
+package v2action
+
+import (
+	"fmt"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// OrganizationNotFoundError is returned when a requested organization is not
+// found.
+type OrganizationNotFoundError struct {
+	GUID string
+	Name string
+}
+
+func (e OrganizationNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Organization '%s' not found", e.Name)
+	}
+	return fmt.Sprintf("Organization with GUID '%s' not found", e.GUID)
+}
+
+// MultipleOrganizationsFoundError is returned when a name lookup for an
+// organization matches more than one GUID.
+type MultipleOrganizationsFoundError struct {
+	Name  string
+	GUIDs []string
+}
+
+func (e MultipleOrganizationsFoundError) Error() string {
+	return fmt.Sprintf("Organization name '%s' matches multiple GUIDs: %s", e.Name, joinGUIDs(e.GUIDs))
+}
+
+// Organization represents a CLI Organization.
+type Organization ccv2.Organization
+
+// GetOrganizationByName returns the organization with the given name.
+func (actor Actor) GetOrganizationByName(name string) (Organization, Warnings, error) {
+	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations([]ccv2.Query{{
+		Filter:   ccv2.NameFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    name,
+	}})
+	if err != nil {
+		return Organization{}, Warnings(warnings), err
+	}
+
+	switch len(orgs) {
+	case 0:
+		return Organization{}, Warnings(warnings), OrganizationNotFoundError{Name: name}
+	case 1:
+		return Organization(orgs[0]), Warnings(warnings), nil
+	default:
+		var guids []string
+		for _, org := range orgs {
+			guids = append(guids, org.GUID)
+		}
+		return Organization{}, Warnings(warnings), MultipleOrganizationsFoundError{Name: name, GUIDs: guids}
+	}
+}
+
+// DeleteOrganization deletes the organization with the given name and waits
+// for the deletion job to complete. It does not clean up any resources
+// nested inside the organization; callers that need that behavior should use
+// DeleteOrganizationRecursively instead.
+func (actor Actor) DeleteOrganization(name string) (Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(name)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	job, deleteWarnings, err := actor.CloudControllerClient.DeleteOrganization(org.GUID)
+	allWarnings = append(allWarnings, deleteWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	pollWarnings, err := actor.pollJob(job)
+	allWarnings = append(allWarnings, pollWarnings...)
+	return allWarnings, err
+}
+
+// OrganizationRecursiveDeleteFailure describes a single child resource that
+// could not be deleted while recursively tearing down an organization.
+type OrganizationRecursiveDeleteFailure struct {
+	ResourceType string
+	GUID         string
+	Name         string
+	Err          error
+}
+
+// OrganizationRecursiveDeleteError is returned when one or more of the
+// resources nested inside an organization fail to delete during a recursive
+// delete. The organization itself is left in place so the operator can
+// retry.
+type OrganizationRecursiveDeleteError struct {
+	OrganizationName string
+	Failures         []OrganizationRecursiveDeleteFailure
+}
+
+func (e OrganizationRecursiveDeleteError) Error() string {
+	return fmt.Sprintf("Failed to delete %d resource(s) nested in organization '%s'", len(e.Failures), e.OrganizationName)
+}
+
+// maxConcurrentSpaceDeletes bounds the number of spaces that are cleaned up
+// at the same time during a recursive organization delete.
+const maxConcurrentSpaceDeletes = 5
+
+// DeleteOrganizationRecursively deletes all spaces, applications, routes,
+// service instances, and service bindings nested inside the named
+// organization before deleting the organization itself. Cleanup of each
+// space is fanned out with bounded concurrency; warnings from every step are
+// aggregated and returned alongside the organization's own warnings. If any
+// child resource fails to delete, the organization is left alone and an
+// OrganizationRecursiveDeleteError listing every failure is returned.
+func (actor Actor) DeleteOrganizationRecursively(name string) (Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(name)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	spaces, spaceWarnings, err := actor.CloudControllerClient.GetSpaces([]ccv2.Query{{
+		Filter:   ccv2.OrganizationGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    org.GUID,
+	}})
+	allWarnings = append(allWarnings, spaceWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var (
+		mu       sync.Mutex
+		failures []OrganizationRecursiveDeleteFailure
+		sem      = make(chan struct{}, maxConcurrentSpaceDeletes)
+		wg       sync.WaitGroup
+	)
+
+	for _, space := range spaces {
+		space := space
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			spaceWarnings, spaceFailures := actor.deleteSpaceResources(space)
+
+			mu.Lock()
+			defer mu.Unlock()
+			allWarnings = append(allWarnings, spaceWarnings...)
+			failures = append(failures, spaceFailures...)
+		}()
+	}
+	wg.Wait()
+
+	if len(failures) > 0 {
+		return allWarnings, OrganizationRecursiveDeleteError{OrganizationName: name, Failures: failures}
+	}
+
+	job, deleteWarnings, err := actor.CloudControllerClient.DeleteOrganization(org.GUID)
+	allWarnings = append(allWarnings, deleteWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	pollWarnings, err := actor.pollJob(job)
+	allWarnings = append(allWarnings, pollWarnings...)
+	return allWarnings, err
+}
+
+// deleteSpaceResources deletes every service binding, service instance,
+// application, and route inside a single space, and finally the space
+// itself, collecting a failure for each resource that could not be removed.
+// The space itself is deleted only when nothing inside it failed.
+// Applications are deleted before routes so that a route is never deleted
+// while still mapped to a live app.
+func (actor Actor) deleteSpaceResources(space ccv2.Space) (Warnings, []OrganizationRecursiveDeleteFailure) {
+	var allWarnings Warnings
+	var failures []OrganizationRecursiveDeleteFailure
+
+	spaceQuery := []ccv2.Query{{
+		Filter:   ccv2.SpaceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    space.GUID,
+	}}
+
+	bindings, bindingWarnings, err := actor.CloudControllerClient.GetServiceBindings(spaceQuery)
+	allWarnings = append(allWarnings, bindingWarnings...)
+	if err != nil {
+		failures = append(failures, OrganizationRecursiveDeleteFailure{ResourceType: "service binding", GUID: space.GUID, Name: space.Name, Err: err})
+	}
+	for _, binding := range bindings {
+		bindingDeleteWarnings, err := actor.CloudControllerClient.DeleteServiceBinding(binding.GUID)
+		allWarnings = append(allWarnings, bindingDeleteWarnings...)
+		if err != nil {
+			failures = append(failures, OrganizationRecursiveDeleteFailure{ResourceType: "service binding", GUID: binding.GUID, Err: err})
+		}
+	}
+
+	instances, instanceWarnings, err := actor.CloudControllerClient.GetServiceInstances(spaceQuery)
+	allWarnings = append(allWarnings, instanceWarnings...)
+	if err != nil {
+		failures = append(failures, OrganizationRecursiveDeleteFailure{ResourceType: "service instance", GUID: space.GUID, Name: space.Name, Err: err})
+	}
+	for _, instance := range instances {
+		instanceDeleteWarnings, err := actor.CloudControllerClient.DeleteServiceInstance(instance.GUID)
+		allWarnings = append(allWarnings, instanceDeleteWarnings...)
+		if err != nil {
+			failures = append(failures, OrganizationRecursiveDeleteFailure{ResourceType: "service instance", GUID: instance.GUID, Name: instance.Name, Err: err})
+		}
+	}
+
+	// Applications are deleted before their routes: a route that is still
+	// mapped to a live app cannot be deleted by the Cloud Controller.
+	apps, appWarnings, err := actor.CloudControllerClient.GetApplications(spaceQuery)
+	allWarnings = append(allWarnings, appWarnings...)
+	if err != nil {
+		failures = append(failures, OrganizationRecursiveDeleteFailure{ResourceType: "application", GUID: space.GUID, Name: space.Name, Err: err})
+	}
+	for _, app := range apps {
+		appDeleteWarnings, err := actor.CloudControllerClient.DeleteApplication(app.GUID)
+		allWarnings = append(allWarnings, appDeleteWarnings...)
+		if err != nil {
+			failures = append(failures, OrganizationRecursiveDeleteFailure{ResourceType: "application", GUID: app.GUID, Name: app.Name, Err: err})
+		}
+	}
+
+	routes, routeWarnings, err := actor.CloudControllerClient.GetRoutes(spaceQuery)
+	allWarnings = append(allWarnings, routeWarnings...)
+	if err != nil {
+		failures = append(failures, OrganizationRecursiveDeleteFailure{ResourceType: "route", GUID: space.GUID, Name: space.Name, Err: err})
+	}
+	for _, route := range routes {
+		routeDeleteWarnings, err := actor.CloudControllerClient.DeleteRoute(route.GUID)
+		allWarnings = append(allWarnings, routeDeleteWarnings...)
+		if err != nil {
+			failures = append(failures, OrganizationRecursiveDeleteFailure{ResourceType: "route", GUID: route.GUID, Err: err})
+		}
+	}
+
+	if len(failures) > 0 {
+		return allWarnings, failures
+	}
+
+	job, deleteWarnings, err := actor.CloudControllerClient.DeleteSpace(space.GUID)
+	allWarnings = append(allWarnings, deleteWarnings...)
+	if err != nil {
+		failures = append(failures, OrganizationRecursiveDeleteFailure{ResourceType: "space", GUID: space.GUID, Name: space.Name, Err: err})
+		return allWarnings, failures
+	}
+
+	pollWarnings, err := actor.pollJob(job)
+	allWarnings = append(allWarnings, pollWarnings...)
+	if err != nil {
+		failures = append(failures, OrganizationRecursiveDeleteFailure{ResourceType: "space", GUID: space.GUID, Name: space.Name, Err: err})
+	}
+
+	return allWarnings, failures
+}
+
+func joinGUIDs(guids []string) string {
+	out := ""
+	for i, guid := range guids {
+		if i > 0 {
+			out += ", "
+		}
+		out += guid
+	}
+	return out
+}
@@ -241,4 +241,194 @@ var _ = Describe("Org Actions", func() {
 			})
 		})
 	})
+
+	Describe("DeleteOrganizationRecursively", func() {
+		var (
+			warnings     Warnings
+			deleteOrgErr error
+			job          ccv2.Job
+		)
+
+		JustBeforeEach(func() {
+			warnings, deleteOrgErr = actor.DeleteOrganizationRecursively("some-org")
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns([]ccv2.Organization{
+				{GUID: "some-org-guid"},
+			}, ccv2.Warnings{"get-org-warning"}, nil)
+		})
+
+		Context("the organization has no spaces", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{}, ccv2.Warnings{"get-spaces-warning"}, nil)
+
+				job = ccv2.Job{
+					GUID:   "some-job-guid",
+					Status: ccv2.JobStatusFinished,
+				}
+
+				fakeCloudControllerClient.DeleteOrganizationReturns(
+					job, ccv2.Warnings{"delete-org-warning"}, nil)
+
+				fakeCloudControllerClient.GetJobReturns(job, ccv2.Warnings{"polling-warnings"}, nil)
+			})
+
+			It("deletes the org without cleaning up any spaces", func() {
+				Expect(deleteOrgErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-spaces-warning", "delete-org-warning", "polling-warnings"))
+
+				Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("the organization has several spaces with no nested resources", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{
+						{GUID: "space-1-guid", Name: "space-1"},
+						{GUID: "space-2-guid", Name: "space-2"},
+					}, ccv2.Warnings{"get-spaces-warning"}, nil)
+
+				fakeCloudControllerClient.GetServiceBindingsReturns(
+					[]ccv2.ServiceBinding{}, ccv2.Warnings{"get-bindings-warning"}, nil)
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv2.ServiceInstance{}, ccv2.Warnings{"get-instances-warning"}, nil)
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv2.Route{}, ccv2.Warnings{"get-routes-warning"}, nil)
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{}, ccv2.Warnings{"get-apps-warning"}, nil)
+
+				fakeCloudControllerClient.DeleteSpaceReturns(
+					ccv2.Job{GUID: "space-job-guid", Status: ccv2.JobStatusFinished},
+					ccv2.Warnings{"delete-space-warning"},
+					nil)
+
+				job = ccv2.Job{
+					GUID:   "some-job-guid",
+					Status: ccv2.JobStatusFinished,
+				}
+				fakeCloudControllerClient.DeleteOrganizationReturns(
+					job, ccv2.Warnings{"delete-org-warning"}, nil)
+				fakeCloudControllerClient.GetJobReturns(job, ccv2.Warnings{"polling-warnings"}, nil)
+			})
+
+			It("deletes every space before deleting the org", func() {
+				Expect(deleteOrgErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("the space has bindings, instances, apps, and routes to clean up", func() {
+			var callOrder []string
+
+			BeforeEach(func() {
+				callOrder = nil
+
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{
+						{GUID: "space-1-guid", Name: "space-1"},
+					}, ccv2.Warnings{"get-spaces-warning"}, nil)
+
+				fakeCloudControllerClient.GetServiceBindingsReturns(
+					[]ccv2.ServiceBinding{{GUID: "binding-1-guid"}},
+					ccv2.Warnings{"get-bindings-warning"}, nil)
+				fakeCloudControllerClient.DeleteServiceBindingStub = func(guid string) (ccv2.Warnings, error) {
+					callOrder = append(callOrder, "service-binding:"+guid)
+					return ccv2.Warnings{"delete-binding-warning"}, nil
+				}
+
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{GUID: "instance-1-guid", Name: "instance-1"}},
+					ccv2.Warnings{"get-instances-warning"}, nil)
+				fakeCloudControllerClient.DeleteServiceInstanceStub = func(guid string) (ccv2.Warnings, error) {
+					callOrder = append(callOrder, "service-instance:"+guid)
+					return ccv2.Warnings{"delete-instance-warning"}, nil
+				}
+
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{{GUID: "app-1-guid", Name: "app-1"}},
+					ccv2.Warnings{"get-apps-warning"}, nil)
+				fakeCloudControllerClient.DeleteApplicationStub = func(guid string) (ccv2.Warnings, error) {
+					callOrder = append(callOrder, "application:"+guid)
+					return ccv2.Warnings{"delete-app-warning"}, nil
+				}
+
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv2.Route{{GUID: "route-1-guid"}},
+					ccv2.Warnings{"get-routes-warning"}, nil)
+				fakeCloudControllerClient.DeleteRouteStub = func(guid string) (ccv2.Warnings, error) {
+					callOrder = append(callOrder, "route:"+guid)
+					return ccv2.Warnings{"delete-route-warning"}, nil
+				}
+
+				fakeCloudControllerClient.DeleteSpaceReturns(
+					ccv2.Job{GUID: "space-job-guid", Status: ccv2.JobStatusFinished},
+					ccv2.Warnings{"delete-space-warning"},
+					nil)
+
+				job = ccv2.Job{
+					GUID:   "some-job-guid",
+					Status: ccv2.JobStatusFinished,
+				}
+				fakeCloudControllerClient.DeleteOrganizationReturns(
+					job, ccv2.Warnings{"delete-org-warning"}, nil)
+				fakeCloudControllerClient.GetJobReturns(job, ccv2.Warnings{"polling-warnings"}, nil)
+			})
+
+			It("deletes every nested resource by GUID, deleting applications before routes", func() {
+				Expect(deleteOrgErr).ToNot(HaveOccurred())
+
+				Expect(fakeCloudControllerClient.DeleteServiceBindingCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteServiceBindingArgsForCall(0)).To(Equal("binding-1-guid"))
+
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)).To(Equal("instance-1-guid"))
+
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("app-1-guid"))
+
+				Expect(fakeCloudControllerClient.DeleteRouteCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteRouteArgsForCall(0)).To(Equal("route-1-guid"))
+
+				Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(1))
+
+				Expect(callOrder).To(Equal([]string{
+					"service-binding:binding-1-guid",
+					"service-instance:instance-1-guid",
+					"application:app-1-guid",
+					"route:route-1-guid",
+				}))
+			})
+		})
+
+		Context("when a nested resource fails to delete", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{
+						{GUID: "space-1-guid", Name: "space-1"},
+					}, ccv2.Warnings{"get-spaces-warning"}, nil)
+
+				fakeCloudControllerClient.GetServiceBindingsReturns(
+					[]ccv2.ServiceBinding{}, nil, nil)
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv2.ServiceInstance{}, nil, nil)
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv2.Route{}, nil, nil)
+
+				expectedErr = errors.New("get-apps-error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{}, nil, expectedErr)
+			})
+
+			It("returns an OrganizationRecursiveDeleteError and does not delete the org", func() {
+				Expect(deleteOrgErr).To(BeAssignableToTypeOf(OrganizationRecursiveDeleteError{}))
+				Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -0,0 +1,94 @@
+package plugin_repo
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+//go:generate counterfeiter . RepoFetcher
+
+// RepoFetcher fetches a plugin repository's index so callers can inspect its
+// reachability and contents without shelling out to `repo-plugins`.
+type RepoFetcher interface {
+	Fetch(url string) (RepoIndex, error)
+}
+
+// RepoIndex describes the reachability and contents of a single plugin
+// repository, as reported by its index.
+type RepoIndex struct {
+	Reachable    bool
+	LastModified time.Time
+	PluginCount  int
+}
+
+type repoIndexResponse struct {
+	Plugins []struct {
+		Updated string `json:"updated"`
+	} `json:"plugins"`
+}
+
+// defaultFetchTimeout bounds how long a single repo fetch can take so a host
+// that accepts the connection and then never responds is reported as
+// unreachable instead of hanging the whole command.
+const defaultFetchTimeout = 10 * time.Second
+
+// HTTPRepoFetcher fetches a repo's index over HTTP.
+type HTTPRepoFetcher struct {
+	Client *http.Client
+}
+
+// NewHTTPRepoFetcher returns a RepoFetcher that issues real HTTP requests,
+// bounded by defaultFetchTimeout.
+func NewHTTPRepoFetcher() HTTPRepoFetcher {
+	return HTTPRepoFetcher{Client: &http.Client{Timeout: defaultFetchTimeout}}
+}
+
+// Fetch retrieves and parses the index at the given repo URL. A repo that
+// cannot be reached or does not return a parseable index is reported as
+// unreachable rather than returned as an error, since an operator running
+// `list-plugin-repos --refresh` expects to see which mirrors are dead, not
+// to have the whole command fail.
+func (f HTTPRepoFetcher) Fetch(url string) (RepoIndex, error) {
+	resp, err := f.Client.Get(url)
+	if err != nil {
+		return RepoIndex{Reachable: false}, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return RepoIndex{Reachable: false}, nil
+	}
+
+	var index repoIndexResponse
+	if err := json.NewDecoder(resp.Body).Decode(&index); err != nil {
+		return RepoIndex{Reachable: false}, nil
+	}
+
+	lastModified := latestUpdateTime(index)
+	if lastModified.IsZero() {
+		if headerTime, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+			lastModified = headerTime
+		}
+	}
+
+	return RepoIndex{
+		Reachable:    true,
+		LastModified: lastModified,
+		PluginCount:  len(index.Plugins),
+	}, nil
+}
+
+func latestUpdateTime(index repoIndexResponse) time.Time {
+	var latest time.Time
+	for _, plugin := range index.Plugins {
+		updated, err := time.Parse(time.RFC3339, plugin.Updated)
+		if err != nil {
+			continue
+		}
+		if updated.After(latest) {
+			latest = updated
+		}
+	}
+	return latest
+}
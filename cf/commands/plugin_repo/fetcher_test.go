@@ -0,0 +1,77 @@
+package plugin_repo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/cloudfoundry/cli/cf/commands/plugin_repo"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTTPRepoFetcher", func() {
+	var (
+		fetcher RepoFetcher
+		server  *httptest.Server
+	)
+
+	BeforeEach(func() {
+		fetcher = NewHTTPRepoFetcher()
+	})
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+		}
+	})
+
+	Context("the repo index is reachable", func() {
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{
+					"plugins": [
+						{"name": "plugin-1", "updated": "2016-01-01T00:00:00Z"},
+						{"name": "plugin-2", "updated": "2016-06-15T00:00:00Z"}
+					]
+				}`))
+			}))
+		})
+
+		It("reports it as reachable with the plugin count and latest update time", func() {
+			index, err := fetcher.Fetch(server.URL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(index.Reachable).To(BeTrue())
+			Expect(index.PluginCount).To(Equal(2))
+			Expect(index.LastModified.Year()).To(Equal(2016))
+			Expect(index.LastModified.Month()).To(Equal(time.June))
+		})
+	})
+
+	Context("the repo returns a non-200 status", func() {
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			}))
+		})
+
+		It("reports it as unreachable", func() {
+			index, err := fetcher.Fetch(server.URL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(index.Reachable).To(BeFalse())
+		})
+	})
+
+	Context("the repo cannot be reached", func() {
+		It("reports it as unreachable", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+			url := server.URL
+			server.Close()
+			server = nil
+
+			index, err := fetcher.Fetch(url)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(index.Reachable).To(BeFalse())
+		})
+	})
+})
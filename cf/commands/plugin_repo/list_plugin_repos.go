@@ -1,18 +1,29 @@
 package plugin_repo
 
 import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/cloudfoundry/cli/cf/command_registry"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 
+	"gopkg.in/yaml.v2"
+
 	. "github.com/cloudfoundry/cli/cf/i18n"
 )
 
 type ListPluginRepos struct {
 	ui     terminal.UI
 	config core_config.Reader
+
+	// Fetcher is exported so tests can inject a fake RepoFetcher in place of
+	// the real HTTP-backed one that SetDependency wires up.
+	Fetcher RepoFetcher
 }
 
 func init() {
@@ -24,14 +35,18 @@ func (cmd *ListPluginRepos) MetaData() command_registry.CommandMetadata {
 		Name:        "list-plugin-repos",
 		Description: T("List all the added plugin repositories"),
 		Usage: []string{
-			T("CF_NAME list-plugin-repos"),
+			T("CF_NAME list-plugin-repos [-o json|yaml] [--refresh]"),
+		},
+		Flags: map[string]flags.FlagSet{
+			"o":       &flags.StringFlag{Name: "o", Usage: T("Output the repository list in the given format: json or yaml")},
+			"refresh": &flags.BoolFlag{Name: "refresh", Usage: T("Fetch each repo's index and annotate rows with reachability, last-modified time, and plugin count")},
 		},
 	}
 }
 
 func (cmd *ListPluginRepos) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
 	if len(fc.Args()) != 0 {
-		cmd.ui.Failed(T("Incorrect Usage")+ ". " + T("No argument required") + "\n\n" + command_registry.Commands.CommandUsage("list-plugin-repos"))
+		cmd.ui.Failed(T("Incorrect Usage") + ". " + T("No argument required") + "\n\n" + command_registry.Commands.CommandUsage("list-plugin-repos"))
 	}
 
 	reqs := []requirements.Requirement{}
@@ -41,16 +56,74 @@ func (cmd *ListPluginRepos) Requirements(requirementsFactory requirements.Factor
 func (cmd *ListPluginRepos) SetDependency(deps command_registry.Dependency, pluginCall bool) command_registry.Command {
 	cmd.ui = deps.Ui
 	cmd.config = deps.Config
+	cmd.Fetcher = NewHTTPRepoFetcher()
 	return cmd
 }
 
+// pluginRepoRow is the machine-readable shape of a single row, with the
+// refresh-only fields omitted unless --refresh was passed. Under --refresh,
+// last_modified and plugin_count are also omitted for an unreachable repo.
+type pluginRepoRow struct {
+	Name         string     `json:"name" yaml:"name"`
+	Url          string     `json:"url" yaml:"url"`
+	Reachable    *bool      `json:"reachable,omitempty" yaml:"reachable,omitempty"`
+	LastModified *time.Time `json:"last_modified,omitempty" yaml:"last_modified,omitempty"`
+	PluginCount  *int       `json:"plugin_count,omitempty" yaml:"plugin_count,omitempty"`
+}
+
 func (cmd *ListPluginRepos) Execute(c flags.FlagContext) {
+	outputFormat := strings.ToLower(c.String("o"))
+	switch outputFormat {
+	case "", "json", "yaml":
+	default:
+		cmd.ui.Failed(T("Incorrect Usage") + ". " + T("Invalid output format: {{.Format}}. Must be 'json' or 'yaml'.", map[string]interface{}{"Format": c.String("o")}) + "\n\n" + command_registry.Commands.CommandUsage("list-plugin-repos"))
+	}
+
 	repos := cmd.config.PluginRepos()
+	refresh := c.Bool("refresh")
 
-	table := terminal.NewTable(cmd.ui, []string{T("Repo Name"), T("Url")})
+	rows := make([]pluginRepoRow, len(repos))
+	for i, repo := range repos {
+		row := pluginRepoRow{Name: repo.Name, Url: repo.Url}
+
+		if refresh {
+			index, _ := cmd.Fetcher.Fetch(repo.Url)
+			reachable := index.Reachable
+			row.Reachable = &reachable
+			if reachable {
+				lastModified := index.LastModified
+				pluginCount := index.PluginCount
+				row.LastModified = &lastModified
+				row.PluginCount = &pluginCount
+			}
+		}
+
+		rows[i] = row
+	}
+
+	switch outputFormat {
+	case "json":
+		cmd.printJSON(rows)
+	case "yaml":
+		cmd.printYAML(rows)
+	default:
+		cmd.printTable(rows, refresh)
+	}
+}
+
+func (cmd *ListPluginRepos) printTable(rows []pluginRepoRow, refresh bool) {
+	headers := []string{T("Repo Name"), T("Url")}
+	if refresh {
+		headers = append(headers, T("Reachable"), T("Last Modified"), T("Plugins"))
+	}
+	table := terminal.NewTable(cmd.ui, headers)
 
-	for _, repo := range repos {
-		table.Add(repo.Name, repo.Url)
+	for _, row := range rows {
+		if refresh {
+			table.Add(row.Name, row.Url, reachableString(row.Reachable), lastModifiedString(row.LastModified), pluginCountString(row.PluginCount))
+		} else {
+			table.Add(row.Name, row.Url)
+		}
 	}
 
 	cmd.ui.Ok()
@@ -60,3 +133,43 @@ func (cmd *ListPluginRepos) Execute(c flags.FlagContext) {
 
 	cmd.ui.Say("")
 }
+
+func (cmd *ListPluginRepos) printJSON(rows []pluginRepoRow) {
+	bytes, err := json.MarshalIndent(rows, "", "  ")
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+	cmd.ui.Say(string(bytes))
+}
+
+func (cmd *ListPluginRepos) printYAML(rows []pluginRepoRow) {
+	bytes, err := yaml.Marshal(rows)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+	cmd.ui.Say(string(bytes))
+}
+
+func reachableString(reachable *bool) string {
+	if reachable == nil {
+		return ""
+	}
+	if *reachable {
+		return T("yes")
+	}
+	return T("no")
+}
+
+func lastModifiedString(lastModified *time.Time) string {
+	if lastModified == nil || lastModified.IsZero() {
+		return ""
+	}
+	return lastModified.Format(time.RFC3339)
+}
+
+func pluginCountString(pluginCount *int) string {
+	if pluginCount == nil {
+		return ""
+	}
+	return strconv.Itoa(*pluginCount)
+}
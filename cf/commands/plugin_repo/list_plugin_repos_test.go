@@ -0,0 +1,135 @@
+package plugin_repo_test
+
+import (
+	"time"
+
+	"github.com/cloudfoundry/cli/cf/command_registry"
+	. "github.com/cloudfoundry/cli/cf/commands/plugin_repo"
+	"github.com/cloudfoundry/cli/cf/commands/plugin_repo/plugin_repofakes"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("list-plugin-repos command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		config              core_config.Repository
+		requirementsFactory *testreq.FakeReqFactory
+		deps                command_registry.Dependency
+		fakeFetcher         *plugin_repofakes.FakeRepoFetcher
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.Ui = ui
+		deps.Config = config
+
+		cmd := command_registry.Commands.FindCommand("list-plugin-repos").SetDependency(deps, pluginCall)
+		if listCmd, ok := cmd.(*ListPluginRepos); ok {
+			listCmd.Fetcher = fakeFetcher
+		}
+		command_registry.Commands.SetCommand(cmd)
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		config = testconfig.NewRepositoryWithDefaults()
+		requirementsFactory = &testreq.FakeReqFactory{}
+		fakeFetcher = new(plugin_repofakes.FakeRepoFetcher)
+
+		config.SetPluginRepo(models.PluginRepo{Name: "repo1", Url: "http://repo1.com"})
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCliCommand("list-plugin-repos", args, requirementsFactory, updateCommandDependency, false)
+	}
+
+	Context("default table output", func() {
+		It("lists the configured repos without touching the fetcher", func() {
+			runCommand()
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Repo Name", "Url"},
+				[]string{"repo1", "http://repo1.com"},
+			))
+			Expect(fakeFetcher.FetchCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("-o with an unrecognized value", func() {
+		It("fails with incorrect usage instead of silently falling back to the table", func() {
+			passed := runCommand("-o", "jsno")
+
+			Expect(passed).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Incorrect Usage"},
+			))
+		})
+	})
+
+	Context("-o json", func() {
+		It("prints the repos as a JSON array", func() {
+			runCommand("-o", "json")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{`"name": "repo1"`},
+				[]string{`"url": "http://repo1.com"`},
+			))
+		})
+	})
+
+	Context("-o yaml", func() {
+		It("prints the repos as YAML", func() {
+			runCommand("-o", "yaml")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"name: repo1"},
+				[]string{"url: http://repo1.com"},
+			))
+		})
+	})
+
+	Context("--refresh", func() {
+		BeforeEach(func() {
+			fakeFetcher.FetchReturns(RepoIndex{
+				Reachable:    true,
+				LastModified: time.Date(2016, 6, 15, 0, 0, 0, 0, time.UTC),
+				PluginCount:  3,
+			}, nil)
+		})
+
+		It("fetches each repo's index and annotates the table with it", func() {
+			runCommand("--refresh")
+
+			Expect(fakeFetcher.FetchCallCount()).To(Equal(1))
+			Expect(fakeFetcher.FetchArgsForCall(0)).To(Equal("http://repo1.com"))
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Repo Name", "Url", "Reachable", "Last Modified", "Plugins"},
+				[]string{"repo1", "http://repo1.com", "yes", "3"},
+			))
+		})
+
+		Context("when the repo is unreachable", func() {
+			BeforeEach(func() {
+				fakeFetcher.FetchReturns(RepoIndex{Reachable: false}, nil)
+			})
+
+			It("marks the row as unreachable", func() {
+				runCommand("--refresh")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"repo1", "http://repo1.com", "no"},
+				))
+			})
+		})
+	})
+})
@@ -0,0 +1,54 @@
+// This file was generated by counterfeiter
+package plugin_repofakes
+
+import (
+	"sync"
+
+	"github.com/cloudfoundry/cli/cf/commands/plugin_repo"
+)
+
+type FakeRepoFetcher struct {
+	FetchStub        func(url string) (plugin_repo.RepoIndex, error)
+	fetchMutex       sync.RWMutex
+	fetchArgsForCall []struct {
+		url string
+	}
+	fetchReturns struct {
+		result1 plugin_repo.RepoIndex
+		result2 error
+	}
+}
+
+func (fake *FakeRepoFetcher) Fetch(url string) (plugin_repo.RepoIndex, error) {
+	fake.fetchMutex.Lock()
+	fake.fetchArgsForCall = append(fake.fetchArgsForCall, struct {
+		url string
+	}{url})
+	fake.fetchMutex.Unlock()
+	if fake.FetchStub != nil {
+		return fake.FetchStub(url)
+	}
+	return fake.fetchReturns.result1, fake.fetchReturns.result2
+}
+
+func (fake *FakeRepoFetcher) FetchCallCount() int {
+	fake.fetchMutex.RLock()
+	defer fake.fetchMutex.RUnlock()
+	return len(fake.fetchArgsForCall)
+}
+
+func (fake *FakeRepoFetcher) FetchArgsForCall(i int) string {
+	fake.fetchMutex.RLock()
+	defer fake.fetchMutex.RUnlock()
+	return fake.fetchArgsForCall[i].url
+}
+
+func (fake *FakeRepoFetcher) FetchReturns(result1 plugin_repo.RepoIndex, result2 error) {
+	fake.FetchStub = nil
+	fake.fetchReturns = struct {
+		result1 plugin_repo.RepoIndex
+		result2 error
+	}{result1, result2}
+}
+
+var _ plugin_repo.RepoFetcher = new(FakeRepoFetcher)
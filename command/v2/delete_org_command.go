@@ -0,0 +1,98 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+)
+
+//go:generate counterfeiter . UI
+
+// UI is the subset of terminal output operations the delete-org command
+// depends on.
+type UI interface {
+	DisplayWarnings(warnings []string)
+	DisplayTextWithFlavor(template string, templateValues ...map[string]interface{})
+	DisplayBoolPrompt(defaultValue bool, template string, templateValues ...map[string]interface{}) (bool, error)
+	DisplayOK()
+}
+
+//go:generate counterfeiter . Config
+
+// Config is the subset of CLI configuration the delete-org command depends
+// on.
+type Config interface {
+	Username() (string, error)
+}
+
+//go:generate counterfeiter . OrgDeleter
+
+// OrgDeleter is satisfied by v2action.Actor. It is narrowed to just the
+// methods this command calls so it can be faked without pulling in the rest
+// of the actor's dependencies.
+type OrgDeleter interface {
+	DeleteOrganization(name string) (v2action.Warnings, error)
+	DeleteOrganizationRecursively(name string) (v2action.Warnings, error)
+}
+
+// DeleteOrgCommand deletes an organization, optionally tearing down every
+// space, app, route, and service nested inside it first.
+type DeleteOrgCommand struct {
+	RequiredArgs struct {
+		Organization string `positional-arg-name:"ORG"`
+	} `positional-args:"yes"`
+	Force     bool `short:"f" description:"Force deletion without confirmation"`
+	Recursive bool `short:"r" long:"recursive" description:"Delete all spaces, apps, routes, and service instances within the organization before deleting the organization itself"`
+
+	UI     UI
+	Config Config
+	Actor  OrgDeleter
+}
+
+// Execute deletes the organization named by RequiredArgs.Organization,
+// recursively tearing down its contents first when Recursive is set.
+func (cmd DeleteOrgCommand) Execute(args []string) error {
+	if !cmd.Force {
+		promptMessage := "Really delete the org {{.OrgName}}?"
+		if cmd.Recursive {
+			promptMessage = "Really delete the org {{.OrgName}}, all of its spaces, and everything within them?"
+		}
+
+		confirmed, err := cmd.UI.DisplayBoolPrompt(false, promptMessage, map[string]interface{}{
+			"OrgName": cmd.RequiredArgs.Organization,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			cmd.UI.DisplayTextWithFlavor("Delete cancelled")
+			return nil
+		}
+	}
+
+	username, err := cmd.Config.Username()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  cmd.RequiredArgs.Organization,
+		"Username": username,
+	})
+
+	var (
+		warnings  v2action.Warnings
+		deleteErr error
+	)
+	if cmd.Recursive {
+		warnings, deleteErr = cmd.Actor.DeleteOrganizationRecursively(cmd.RequiredArgs.Organization)
+	} else {
+		warnings, deleteErr = cmd.Actor.DeleteOrganization(cmd.RequiredArgs.Organization)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if deleteErr != nil {
+		return deleteErr
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,102 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("delete-org Command", func() {
+	var (
+		cmd        DeleteOrgCommand
+		fakeUI     *v2fakes.FakeUI
+		fakeConfig *v2fakes.FakeConfig
+		fakeActor  *v2fakes.FakeOrgDeleter
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeUI = new(v2fakes.FakeUI)
+		fakeConfig = new(v2fakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeOrgDeleter)
+
+		fakeConfig.UsernameReturns("some-user", nil)
+		fakeUI.DisplayBoolPromptReturns(true, nil)
+
+		cmd = DeleteOrgCommand{
+			UI:     fakeUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+			Force:  true,
+		}
+		cmd.RequiredArgs.Organization = "some-org"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when -f is not passed and the user declines the prompt", func() {
+		BeforeEach(func() {
+			cmd.Force = false
+			fakeUI.DisplayBoolPromptReturns(false, nil)
+		})
+
+		It("does not delete the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+			Expect(fakeActor.DeleteOrganizationRecursivelyCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --recursive is not passed", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteOrganizationReturns(v2action.Warnings{"delete-warning"}, nil)
+		})
+
+		It("deletes the org non-recursively", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
+			Expect(fakeActor.DeleteOrganizationArgsForCall(0)).To(Equal("some-org"))
+			Expect(fakeActor.DeleteOrganizationRecursivelyCallCount()).To(Equal(0))
+
+			Expect(fakeUI.DisplayWarningsArgsForCall(0)).To(Equal([]string{"delete-warning"}))
+			Expect(fakeUI.DisplayOKCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when --recursive is passed", func() {
+		BeforeEach(func() {
+			cmd.Recursive = true
+			fakeActor.DeleteOrganizationRecursivelyReturns(v2action.Warnings{"recursive-delete-warning"}, nil)
+		})
+
+		It("deletes the org recursively", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.DeleteOrganizationRecursivelyCallCount()).To(Equal(1))
+			Expect(fakeActor.DeleteOrganizationRecursivelyArgsForCall(0)).To(Equal("some-org"))
+			Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+
+			Expect(fakeUI.DisplayWarningsArgsForCall(0)).To(Equal([]string{"recursive-delete-warning"}))
+			Expect(fakeUI.DisplayOKCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when deleting the org returns an error", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("delete-org-error")
+			fakeActor.DeleteOrganizationReturns(v2action.Warnings{"delete-warning"}, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(fakeUI.DisplayOKCallCount()).To(Equal(0))
+		})
+	})
+})
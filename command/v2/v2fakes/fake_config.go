@@ -0,0 +1,38 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	v2 "code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeConfig struct {
+	UsernameStub        func() (string, error)
+	usernameMutex       sync.RWMutex
+	usernameArgsForCall []struct{}
+	usernameReturns     struct {
+		result1 string
+		result2 error
+	}
+}
+
+func (fake *FakeConfig) Username() (string, error) {
+	fake.usernameMutex.Lock()
+	fake.usernameArgsForCall = append(fake.usernameArgsForCall, struct{}{})
+	fake.usernameMutex.Unlock()
+	if fake.UsernameStub != nil {
+		return fake.UsernameStub()
+	}
+	return fake.usernameReturns.result1, fake.usernameReturns.result2
+}
+
+func (fake *FakeConfig) UsernameReturns(result1 string, result2 error) {
+	fake.UsernameStub = nil
+	fake.usernameReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+var _ v2.Config = new(FakeConfig)
@@ -0,0 +1,89 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	v2 "code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeOrgDeleter struct {
+	DeleteOrganizationStub        func(name string) (v2action.Warnings, error)
+	deleteOrganizationMutex       sync.RWMutex
+	deleteOrganizationArgsForCall []struct{ name string }
+	deleteOrganizationReturns     struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+
+	DeleteOrganizationRecursivelyStub        func(name string) (v2action.Warnings, error)
+	deleteOrganizationRecursivelyMutex       sync.RWMutex
+	deleteOrganizationRecursivelyArgsForCall []struct{ name string }
+	deleteOrganizationRecursivelyReturns     struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+}
+
+func (fake *FakeOrgDeleter) DeleteOrganization(name string) (v2action.Warnings, error) {
+	fake.deleteOrganizationMutex.Lock()
+	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct{ name string }{name})
+	fake.deleteOrganizationMutex.Unlock()
+	if fake.DeleteOrganizationStub != nil {
+		return fake.DeleteOrganizationStub(name)
+	}
+	return fake.deleteOrganizationReturns.result1, fake.deleteOrganizationReturns.result2
+}
+
+func (fake *FakeOrgDeleter) DeleteOrganizationCallCount() int {
+	fake.deleteOrganizationMutex.RLock()
+	defer fake.deleteOrganizationMutex.RUnlock()
+	return len(fake.deleteOrganizationArgsForCall)
+}
+
+func (fake *FakeOrgDeleter) DeleteOrganizationArgsForCall(i int) string {
+	fake.deleteOrganizationMutex.RLock()
+	defer fake.deleteOrganizationMutex.RUnlock()
+	return fake.deleteOrganizationArgsForCall[i].name
+}
+
+func (fake *FakeOrgDeleter) DeleteOrganizationReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteOrganizationStub = nil
+	fake.deleteOrganizationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrgDeleter) DeleteOrganizationRecursively(name string) (v2action.Warnings, error) {
+	fake.deleteOrganizationRecursivelyMutex.Lock()
+	fake.deleteOrganizationRecursivelyArgsForCall = append(fake.deleteOrganizationRecursivelyArgsForCall, struct{ name string }{name})
+	fake.deleteOrganizationRecursivelyMutex.Unlock()
+	if fake.DeleteOrganizationRecursivelyStub != nil {
+		return fake.DeleteOrganizationRecursivelyStub(name)
+	}
+	return fake.deleteOrganizationRecursivelyReturns.result1, fake.deleteOrganizationRecursivelyReturns.result2
+}
+
+func (fake *FakeOrgDeleter) DeleteOrganizationRecursivelyCallCount() int {
+	fake.deleteOrganizationRecursivelyMutex.RLock()
+	defer fake.deleteOrganizationRecursivelyMutex.RUnlock()
+	return len(fake.deleteOrganizationRecursivelyArgsForCall)
+}
+
+func (fake *FakeOrgDeleter) DeleteOrganizationRecursivelyArgsForCall(i int) string {
+	fake.deleteOrganizationRecursivelyMutex.RLock()
+	defer fake.deleteOrganizationRecursivelyMutex.RUnlock()
+	return fake.deleteOrganizationRecursivelyArgsForCall[i].name
+}
+
+func (fake *FakeOrgDeleter) DeleteOrganizationRecursivelyReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteOrganizationRecursivelyStub = nil
+	fake.deleteOrganizationRecursivelyReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+var _ v2.OrgDeleter = new(FakeOrgDeleter)
@@ -0,0 +1,109 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	v2 "code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeUI struct {
+	DisplayWarningsStub        func(warnings []string)
+	displayWarningsMutex       sync.RWMutex
+	displayWarningsArgsForCall []struct{ warnings []string }
+
+	DisplayTextWithFlavorStub        func(template string, templateValues ...map[string]interface{})
+	displayTextWithFlavorMutex       sync.RWMutex
+	displayTextWithFlavorArgsForCall []struct {
+		template       string
+		templateValues []map[string]interface{}
+	}
+
+	DisplayBoolPromptStub        func(defaultValue bool, template string, templateValues ...map[string]interface{}) (bool, error)
+	displayBoolPromptMutex       sync.RWMutex
+	displayBoolPromptArgsForCall []struct {
+		defaultValue   bool
+		template       string
+		templateValues []map[string]interface{}
+	}
+	displayBoolPromptReturns struct {
+		result1 bool
+		result2 error
+	}
+
+	DisplayOKStub        func()
+	displayOKMutex       sync.RWMutex
+	displayOKArgsForCall []struct{}
+}
+
+func (fake *FakeUI) DisplayWarnings(warnings []string) {
+	fake.displayWarningsMutex.Lock()
+	fake.displayWarningsArgsForCall = append(fake.displayWarningsArgsForCall, struct{ warnings []string }{warnings})
+	fake.displayWarningsMutex.Unlock()
+	if fake.DisplayWarningsStub != nil {
+		fake.DisplayWarningsStub(warnings)
+	}
+}
+
+func (fake *FakeUI) DisplayWarningsArgsForCall(i int) []string {
+	fake.displayWarningsMutex.RLock()
+	defer fake.displayWarningsMutex.RUnlock()
+	return fake.displayWarningsArgsForCall[i].warnings
+}
+
+func (fake *FakeUI) DisplayTextWithFlavor(template string, templateValues ...map[string]interface{}) {
+	fake.displayTextWithFlavorMutex.Lock()
+	fake.displayTextWithFlavorArgsForCall = append(fake.displayTextWithFlavorArgsForCall, struct {
+		template       string
+		templateValues []map[string]interface{}
+	}{template, templateValues})
+	fake.displayTextWithFlavorMutex.Unlock()
+	if fake.DisplayTextWithFlavorStub != nil {
+		fake.DisplayTextWithFlavorStub(template, templateValues...)
+	}
+}
+
+func (fake *FakeUI) DisplayTextWithFlavorCallCount() int {
+	fake.displayTextWithFlavorMutex.RLock()
+	defer fake.displayTextWithFlavorMutex.RUnlock()
+	return len(fake.displayTextWithFlavorArgsForCall)
+}
+
+func (fake *FakeUI) DisplayBoolPrompt(defaultValue bool, template string, templateValues ...map[string]interface{}) (bool, error) {
+	fake.displayBoolPromptMutex.Lock()
+	fake.displayBoolPromptArgsForCall = append(fake.displayBoolPromptArgsForCall, struct {
+		defaultValue   bool
+		template       string
+		templateValues []map[string]interface{}
+	}{defaultValue, template, templateValues})
+	fake.displayBoolPromptMutex.Unlock()
+	if fake.DisplayBoolPromptStub != nil {
+		return fake.DisplayBoolPromptStub(defaultValue, template, templateValues...)
+	}
+	return fake.displayBoolPromptReturns.result1, fake.displayBoolPromptReturns.result2
+}
+
+func (fake *FakeUI) DisplayBoolPromptReturns(result1 bool, result2 error) {
+	fake.DisplayBoolPromptStub = nil
+	fake.displayBoolPromptReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUI) DisplayOK() {
+	fake.displayOKMutex.Lock()
+	fake.displayOKArgsForCall = append(fake.displayOKArgsForCall, struct{}{})
+	fake.displayOKMutex.Unlock()
+	if fake.DisplayOKStub != nil {
+		fake.DisplayOKStub()
+	}
+}
+
+func (fake *FakeUI) DisplayOKCallCount() int {
+	fake.displayOKMutex.RLock()
+	defer fake.displayOKMutex.RUnlock()
+	return len(fake.displayOKArgsForCall)
+}
+
+var _ v2.UI = new(FakeUI)